@@ -0,0 +1,65 @@
+package backstage
+
+import (
+	"context"
+	"net/http"
+
+	"golang.org/x/oauth2"
+)
+
+// Authenticator applies authentication to an outgoing request, e.g. by setting the Authorization header.
+type Authenticator interface {
+	Apply(ctx context.Context, req *http.Request) error
+}
+
+type bearerTokenAuth struct {
+	token string
+}
+
+// BearerTokenAuth returns an Authenticator that sends a static JWT as a Bearer token. This is the
+// backward-compatible equivalent of the raw token string NewClient used to accept.
+func BearerTokenAuth(token string) Authenticator {
+	return bearerTokenAuth{token: token}
+}
+
+func (a bearerTokenAuth) Apply(_ context.Context, req *http.Request) error {
+	if a.token != "" {
+		req.Header.Set("Authorization", "Bearer "+a.token)
+	}
+	return nil
+}
+
+type staticAuth struct {
+	header string
+	value  string
+}
+
+// StaticAuth returns an Authenticator that sets a fixed header on every request, e.g. for Basic auth
+// during local development: StaticAuth("Authorization", "Basic "+base64Creds).
+func StaticAuth(header, value string) Authenticator {
+	return staticAuth{header: header, value: value}
+}
+
+func (a staticAuth) Apply(_ context.Context, req *http.Request) error {
+	req.Header.Set(a.header, a.value)
+	return nil
+}
+
+type oauth2Auth struct {
+	ts oauth2.TokenSource
+}
+
+// OAuth2Auth returns an Authenticator that fetches a fresh token from ts on every request, suitable for
+// backends that rotate short-lived service-to-service tokens via OIDC.
+func OAuth2Auth(ts oauth2.TokenSource) Authenticator {
+	return oauth2Auth{ts: ts}
+}
+
+func (a oauth2Auth) Apply(_ context.Context, req *http.Request) error {
+	token, err := a.ts.Token()
+	if err != nil {
+		return err
+	}
+	token.SetAuthHeader(req)
+	return nil
+}
@@ -0,0 +1,54 @@
+package backstage
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+// TestStaticAuth_Apply tests that StaticAuth sets the configured header verbatim.
+func TestStaticAuth_Apply(t *testing.T) {
+	auth := StaticAuth("Authorization", "Basic dXNlcjpwYXNz")
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/foo", nil)
+
+	err := auth.Apply(context.Background(), req)
+
+	assert.NoError(t, err, "Apply should not return an error")
+	assert.Equal(t, "Basic dXNlcjpwYXNz", req.Header.Get("Authorization"), "Apply should set the configured header to the configured value")
+}
+
+type fakeTokenSource struct {
+	token *oauth2.Token
+	err   error
+}
+
+func (s fakeTokenSource) Token() (*oauth2.Token, error) {
+	return s.token, s.err
+}
+
+// TestOAuth2Auth_Apply tests that OAuth2Auth fetches a fresh token and sets it as the Authorization header.
+func TestOAuth2Auth_Apply(t *testing.T) {
+	auth := OAuth2Auth(fakeTokenSource{token: &oauth2.Token{AccessToken: "my-access-token", TokenType: "Bearer"}})
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/foo", nil)
+
+	err := auth.Apply(context.Background(), req)
+
+	assert.NoError(t, err, "Apply should not return an error")
+	assert.Equal(t, "Bearer my-access-token", req.Header.Get("Authorization"), "Apply should set the Authorization header from the fetched token")
+}
+
+// TestOAuth2Auth_Apply_TokenError tests that OAuth2Auth surfaces an error from the underlying TokenSource.
+func TestOAuth2Auth_Apply_TokenError(t *testing.T) {
+	wantErr := errors.New("token refresh failed")
+	auth := OAuth2Auth(fakeTokenSource{err: wantErr})
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/foo", nil)
+
+	err := auth.Apply(context.Background(), req)
+
+	assert.Equal(t, wantErr, err, "Apply should return the error from the TokenSource")
+	assert.Empty(t, req.Header.Get("Authorization"), "Apply should not set an Authorization header when the TokenSource fails")
+}
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 type service struct {
@@ -38,13 +40,34 @@ type Client struct {
 	// Catalog service to handle communication with the Backstage Catalog API.
 	Catalog *catalogService
 
-	// Token is an optional JWT token for authenticating to the Backstage API.
-	token string
+	// auth applies authentication to outgoing requests, e.g. a Bearer token or OAuth2 token source.
+	auth Authenticator
+
+	// MaxRetries is the number of times a request will be retried if it matches one of retryConditions.
+	MaxRetries int
+
+	retryConditions []RetryConditional
+	requestHooks    []RequestHook
+	responseHooks   []ResponseHook
+	cache           Cache
+}
+
+// ClientOption configures optional behavior on a Client. Pass one or more to NewClient.
+type ClientOption func(*Client)
+
+// WithRetryPolicy overrides the default retry policy, setting the maximum number of retries and
+// replacing the default retry conditions with the ones given.
+func WithRetryPolicy(maxRetries int, conditions ...RetryConditional) ClientOption {
+	return func(c *Client) {
+		c.MaxRetries = maxRetries
+		c.retryConditions = conditions
+	}
 }
 
 // NewClient returns a new Backstage API client. If a nil httpClient is  provided, a new http.Client will be used.
-// To use API methods which require authentication, provide a JWT token which will be passed to Backstage on all requests.
-func NewClient(baseURL string, defaultNamespace string, httpClient *http.Client, token string) (*Client, error) {
+// To use API methods which require authentication, provide an Authenticator which will be applied to Backstage on
+// all requests. Callers that only have a static JWT can pass BearerTokenAuth(token) for auth.
+func NewClient(baseURL string, defaultNamespace string, httpClient *http.Client, auth Authenticator, opts ...ClientOption) (*Client, error) {
 	const apiPath = "/api"
 
 	baseURL = strings.TrimSuffix(baseURL, "/")
@@ -71,7 +94,16 @@ func NewClient(baseURL string, defaultNamespace string, httpClient *http.Client,
 		BaseURL:          baseEndpoint,
 		UserAgent:        userAgent,
 		DefaultNamespace: ns,
-		token:            token,
+		auth:             auth,
+		MaxRetries:       defaultMaxRetries,
+		cache:            newMemoryCache(defaultCacheTTL, defaultCacheMaxEntries),
+	}
+	c.AddRetryCondition(retryOnTooManyRequests)
+	c.AddRetryCondition(retryOnServerUnavailable)
+	c.AddRetryCondition(retryOnConnectionReset)
+
+	for _, opt := range opts {
+		opt(c)
 	}
 
 	c.Catalog = newCatalogService(c)
@@ -80,7 +112,7 @@ func NewClient(baseURL string, defaultNamespace string, httpClient *http.Client,
 }
 
 // newRequest creates an API request. A relative URL can be provided in urlStr, in which case it is resolved relative to the BaseURL.
-func (c *Client) newRequest(method string, urlStr string, body interface{}) (*http.Request, error) {
+func (c *Client) newRequest(ctx context.Context, method string, urlStr string, body interface{}, opts ...RequestOption) (*http.Request, error) {
 	u, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -118,16 +150,77 @@ func (c *Client) newRequest(method string, urlStr string, body interface{}) (*ht
 	if c.UserAgent != "" {
 		req.Header.Set("User-Agent", c.UserAgent)
 	}
-	if c.token != "" {
-		req.Header.Set("Authorization", "Bearer "+c.token)
+	if c.auth != nil {
+		if err := c.auth.Apply(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	if method == http.MethodGet && c.cache != nil && req.Header.Get("Cache-Control") != "no-cache" {
+		if entry, ok := c.cache.Get(resolvedURL); ok {
+			if entry.ETag != "" {
+				req.Header.Set("If-None-Match", entry.ETag)
+			} else if entry.LastModified != "" {
+				req.Header.Set("If-Modified-Since", entry.LastModified)
+			}
+		}
 	}
 
 	return req, nil
 }
 
 // do send an API request and returns the API response. The API response is JSON decoded and stored in the value pointed to by v.
+// Requests that match one of the Client's retry conditions are retried, up to MaxRetries times, with a full-jitter
+// exponential backoff between attempts. A response with a status of 400 or above is not decoded into v;
+// do returns it with an *ErrorResponse, or a generic error holding the raw body when that body is not
+// Backstage's error envelope.
 func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
-	resp, err := c.client.Do(req.WithContext(ctx))
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, bodyErr
+			}
+			req.Body = body
+		}
+
+		for _, hook := range c.requestHooks {
+			hook(req)
+		}
+
+		start := time.Now()
+		resp, err = c.client.Do(req.WithContext(ctx))
+		duration := time.Since(start)
+
+		if err == nil && len(c.responseHooks) > 0 {
+			if hookErr := c.runResponseHooks(resp, duration); hookErr != nil {
+				return resp, hookErr
+			}
+		}
+
+		if !c.shouldRetry(resp, err) || attempt >= c.MaxRetries {
+			break
+		}
+
+		delay := retryDelay(attempt, resp)
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +229,41 @@ func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*htt
 		_ = Body.Close()
 	}(resp.Body)
 
+	if resp.StatusCode == http.StatusNotModified {
+		if c.cache != nil {
+			if entry, ok := c.cache.Get(req.URL.String()); ok {
+				return resp, json.Unmarshal(entry.Body, v)
+			}
+		}
+		return resp, fmt.Errorf("received 304 Not Modified but no cached entry for %s", req.URL)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, readErr := io.ReadAll(resp.Body)
+		if readErr != nil {
+			return resp, readErr
+		}
+		return resp, checkResponse(resp, body)
+	}
+
+	if req.Method == http.MethodGet && c.cache != nil && req.Header.Get("Cache-Control") != "no-cache" {
+		body, readErr := io.ReadAll(resp.Body)
+		if readErr != nil {
+			return resp, readErr
+		}
+
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			c.cache.Set(req.URL.String(), &CacheEntry{ETag: etag, Body: body})
+		} else if lastModified := resp.Header.Get("Last-Modified"); lastModified != "" {
+			c.cache.Set(req.URL.String(), &CacheEntry{LastModified: lastModified, Body: body})
+		}
+
+		if len(body) == 0 {
+			return resp, nil
+		}
+		return resp, json.Unmarshal(body, v)
+	}
+
 	decErr := json.NewDecoder(resp.Body).Decode(v)
 	if decErr == io.EOF {
 		decErr = nil
@@ -143,3 +271,20 @@ func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*htt
 
 	return resp, decErr
 }
+
+// runResponseHooks buffers resp.Body so every registered ResponseHook can inspect the raw bytes,
+// then restores resp.Body so the rest of do can still decode it.
+func (c *Client) runResponseHooks(resp *http.Response, duration time.Duration) error {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	for _, hook := range c.responseHooks {
+		hook(resp, body, duration)
+	}
+
+	return nil
+}
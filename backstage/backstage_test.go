@@ -20,7 +20,7 @@ func TestNewClient(t *testing.T) {
 	const baseURL = "http://localhost:7007/api"
 	const nameSpace = "custom"
 
-	c, err := NewClient(baseURL, nameSpace, nil)
+	c, err := NewClient(baseURL, nameSpace, nil, nil)
 
 	assert.NoError(t, err, "New client should not return an error")
 	assert.Equal(t, baseURL, c.BaseURL.String(), "Base URL should match the one provided")
@@ -35,7 +35,7 @@ func TestNewClient_ExistingHTTPClient(t *testing.T) {
 	ec := &http.Client{
 		Timeout: timeout,
 	}
-	c, err := NewClient(baseURL, "", ec)
+	c, err := NewClient(baseURL, "", ec, nil)
 
 	assert.NoError(t, err, "New client should not return an error")
 	assert.Equal(t, timeout, c.client.Timeout, "Timeout should match the one from the existing HTTP client")
@@ -43,13 +43,13 @@ func TestNewClient_ExistingHTTPClient(t *testing.T) {
 
 // TestNewClient_InvalidBaseURL tests if an error is returned when the base URL is invalid.
 func TestNewClient_InvalidBaseURL(t *testing.T) {
-	_, err := NewClient("\\foo:bar", "", nil)
+	_, err := NewClient("\\foo:bar", "", nil, nil)
 	assert.Error(t, err, "New client should return an error when the base URL is invalid")
 }
 
 // TestNewClient_TrimBaseURLSuffix tests the creation of a new Backstage client with a base URL that has a trailing slash.
 func TestNewClient_TrimBaseURLSuffix(t *testing.T) {
-	c, err := NewClient("http://localhost:7007/api/", "", nil)
+	c, err := NewClient("http://localhost:7007/api/", "", nil, nil)
 
 	assert.NoError(t, err, "New client should not return an error")
 	assert.Equal(t, "http://localhost:7007/api", c.BaseURL.String(), "Base URL not contain a trailing slash")
@@ -57,10 +57,10 @@ func TestNewClient_TrimBaseURLSuffix(t *testing.T) {
 
 // TestNewClient_DefaultNamespace tests that namespace is set to default if not provided.
 func TestNewClient_DefaultNamespace(t *testing.T) {
-	c, err := NewClient("http://localhost:7007/api/", "", nil)
+	c, err := NewClient("http://localhost:7007/api/", "", nil, nil)
 
 	assert.NoError(t, err, "New client should not return an error")
-	assert.Equal(t, defaultNamespaceName, c.DefaultNamespace, "Default namespace should be set to default if not provided")
+	assert.Equal(t, DefaultNamespaceName, c.DefaultNamespace, "Default namespace should be set to default if not provided")
 }
 
 // TestNewRequest_Get tests the creation of a new GET request.
@@ -74,7 +74,7 @@ func TestNewRequest_Get(t *testing.T) {
 		UserAgent: userAgent,
 		BaseURL:   baseURL,
 	}
-	req, err := c.newRequest(http.MethodGet, path, nil)
+	req, err := c.newRequest(context.Background(), http.MethodGet, path, nil)
 
 	assert.NoError(t, err, "New request should not return an error")
 	assert.Equal(t, http.MethodGet, req.Method, "Request method should match the one provided")
@@ -83,12 +83,21 @@ func TestNewRequest_Get(t *testing.T) {
 	assert.Equal(t, userAgent, req.Header.Get("User-Agent"), "Request should have a User-Agent header set to the one provided")
 }
 
+// TestNewRequest_Auth tests that the configured Authenticator is applied to the request.
+func TestNewRequest_Auth(t *testing.T) {
+	c := &Client{auth: BearerTokenAuth("my-token")}
+	req, err := c.newRequest(context.Background(), http.MethodGet, "/foo/bar", nil)
+
+	assert.NoError(t, err, "New request should not return an error")
+	assert.Equal(t, "Bearer my-token", req.Header.Get("Authorization"), "Request should carry the Authorization header applied by the Authenticator")
+}
+
 // TestNewRequest_Post tests the creation of a new POST request.
 func TestNewRequest_Post(t *testing.T) {
 	const url = "http://localhost:7007/api/catalog/entities"
 
 	c := &Client{}
-	req, err := c.newRequest(http.MethodPost, url, struct {
+	req, err := c.newRequest(context.Background(), http.MethodPost, url, struct {
 		Foo string
 	}{
 		Foo: "Bar",
@@ -107,7 +116,7 @@ func TestNewRequest_Post(t *testing.T) {
 // TestNewRequest_InvalidURL tests if an error is returned when the URL of the request is invalid.
 func TestNewRequest_InvalidURL(t *testing.T) {
 	c := &Client{}
-	_, err := c.newRequest(http.MethodGet, "\\foo:bar", nil)
+	_, err := c.newRequest(context.Background(), http.MethodGet, "\\foo:bar", nil)
 	assert.Error(t, err, "New request should return an error when the URL is invalid")
 }
 
@@ -133,7 +142,7 @@ func TestDo(t *testing.T) {
 	}
 
 	data := new(interface{})
-	req, _ := c.newRequest(http.MethodGet, path, nil)
+	req, _ := c.newRequest(context.Background(), http.MethodGet, path, nil)
 	resp, err := c.do(context.Background(), req, data)
 	dataJSON, _ := json.Marshal(data)
 
@@ -158,7 +167,7 @@ func TestDo_EmptyBody(t *testing.T) {
 		client:  &http.Client{},
 	}
 
-	req, _ := c.newRequest(http.MethodGet, path, nil)
+	req, _ := c.newRequest(context.Background(), http.MethodGet, path, nil)
 	resp, err := c.do(context.Background(), req, nil)
 	buf := new(strings.Builder)
 	_, _ = io.Copy(buf, resp.Body)
@@ -175,8 +184,127 @@ func TestDo_Fail(t *testing.T) {
 		client:  &http.Client{},
 	}
 
-	req, _ := c.newRequest(http.MethodGet, "/foo/bar", nil)
+	req, _ := c.newRequest(context.Background(), http.MethodGet, "/foo/bar", nil)
 	_, err := c.do(context.Background(), req, nil)
 
 	assert.Error(t, err, "Do should return an error when request fails")
 }
+
+// TestDo_ErrorResponse tests that a non-2xx response is decoded into an ErrorResponse instead of v.
+func TestDo_ErrorResponse(t *testing.T) {
+	const path = "/foo/bar"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"name":"NotFoundError","message":"entity not found","statusCode":404}}`))
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	c := &Client{
+		BaseURL: u,
+		client:  &http.Client{},
+	}
+
+	req, _ := c.newRequest(context.Background(), http.MethodGet, path, nil)
+	data := new(interface{})
+	resp, err := c.do(context.Background(), req, data)
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode, "Response status code should match the one from the server")
+	assert.True(t, IsNotFound(err), "IsNotFound should report true for a 404 ErrorResponse")
+	assert.False(t, IsUnauthorized(err), "IsUnauthorized should report false for a 404 ErrorResponse")
+
+	var errResp *ErrorResponse
+	assert.ErrorAs(t, err, &errResp, "Error should be an *ErrorResponse")
+	assert.Equal(t, "entity not found", errResp.Error_.Message, "ErrorResponse should carry Backstage's error message")
+
+	// The envelope served here carries no "request" object, so Error() must derive the method/URL
+	// from the HTTP response instead of the zero-valued JSON fields.
+	wantPrefix := http.MethodGet + " " + server.URL + path + ":"
+	assert.True(t, strings.HasPrefix(errResp.Error(), wantPrefix), "Error() should start with %q, got %q", wantPrefix, errResp.Error())
+}
+
+// TestDo_RetriesOnServiceUnavailable tests that a 503 response is retried until the server recovers.
+func TestDo_RetriesOnServiceUnavailable(t *testing.T) {
+	const path = "/foo/bar"
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"foo":"bar"}`))
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	c, _ := NewClient(server.URL, "", nil, nil)
+	c.BaseURL = u
+
+	data := new(interface{})
+	req, _ := c.newRequest(context.Background(), http.MethodGet, path, nil)
+	resp, err := c.do(context.Background(), req, data)
+
+	assert.NoError(t, err, "Do should not return an error once the server recovers")
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "Response status code should match the final response from the server")
+	assert.Equal(t, 3, requests, "Do should have retried twice before succeeding")
+}
+
+// TestDo_GivesUpAfterMaxRetries tests that Do stops retrying once MaxRetries is exhausted.
+func TestDo_GivesUpAfterMaxRetries(t *testing.T) {
+	const path = "/foo/bar"
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	c, _ := NewClient(server.URL, "", nil, nil, WithRetryPolicy(2, retryOnServerUnavailable))
+	c.BaseURL = u
+
+	req, _ := c.newRequest(context.Background(), http.MethodGet, path, nil)
+	resp, err := c.do(context.Background(), req, nil)
+
+	assert.Error(t, err, "Do should return an error once retries are exhausted and the server is still unavailable")
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode, "Response should be the final 503 from the server")
+	assert.Equal(t, 3, requests, "Do should have made the initial request plus 2 retries")
+}
+
+// TestDo_Hooks tests that registered RequestHooks and ResponseHooks observe the request and
+// response, and that the response body is still decoded into v afterwards.
+func TestDo_Hooks(t *testing.T) {
+	const path = "/foo/bar"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"foo":"bar"}`))
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	c := &Client{BaseURL: u, client: &http.Client{}}
+
+	var requestSeen *http.Request
+	var responseBody []byte
+	c.OnRequest(func(req *http.Request) { requestSeen = req })
+	c.OnResponse(func(resp *http.Response, body []byte, duration time.Duration) { responseBody = body })
+
+	data := new(interface{})
+	req, _ := c.newRequest(context.Background(), http.MethodGet, path, nil)
+	_, err := c.do(context.Background(), req, data)
+	dataJSON, _ := json.Marshal(data)
+
+	assert.NoError(t, err, "Do should not return an error")
+	assert.Equal(t, path, requestSeen.URL.Path, "RequestHook should observe the outgoing request")
+	assert.Equal(t, `{"foo":"bar"}`, string(responseBody), "ResponseHook should observe the raw response body")
+	assert.Equal(t, `{"foo":"bar"}`, string(dataJSON), "Do should still decode the response body into v after running hooks")
+}
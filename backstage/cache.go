@@ -0,0 +1,165 @@
+package backstage
+
+import (
+	"container/list"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultCacheTTL        = 30 * time.Second
+	defaultCacheMaxEntries = 1000
+)
+
+// CacheEntry is a cached GET response, keyed by its resolved request URL, along with the HTTP
+// validators needed to revalidate it.
+type CacheEntry struct {
+	ETag         string
+	LastModified string
+	Body         []byte
+	StoredAt     time.Time
+}
+
+// Cache stores CacheEntry values keyed by resolved request URL. Implementations must be safe for
+// concurrent use. Users who want a shared cache (e.g. backed by Redis) can implement this interface
+// and register it with Client.SetCache.
+type Cache interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	Invalidate(prefix string)
+}
+
+// SetCache replaces the Client's cache. Pass nil to disable caching.
+func (c *Client) SetCache(cache Cache) {
+	c.cache = cache
+}
+
+// InvalidateCache removes every cache entry whose URL starts with prefix. Call this after catalog
+// mutations to avoid serving stale reads.
+func (c *Client) InvalidateCache(prefix string) {
+	if c.cache != nil {
+		c.cache.Invalidate(prefix)
+	}
+}
+
+// RequestOption customizes a single request built by newRequest.
+type RequestOption func(*http.Request)
+
+// WithNoCache sets Cache-Control: no-cache on a request, bypassing cache lookups and writes for it.
+func WithNoCache() RequestOption {
+	return func(req *http.Request) {
+		req.Header.Set("Cache-Control", "no-cache")
+	}
+}
+
+type cacheItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// memoryCache is the default Cache implementation: an in-memory store with a TTL per entry and LRU
+// eviction once maxEntries is exceeded.
+type memoryCache struct {
+	entries sync.Map // string -> *list.Element, Element.Value is *cacheItem
+
+	mu         sync.Mutex
+	order      *list.List
+	ttl        time.Duration
+	maxEntries int
+}
+
+func newMemoryCache(ttl time.Duration, maxEntries int) *memoryCache {
+	return &memoryCache{
+		order:      list.New(),
+		ttl:        ttl,
+		maxEntries: maxEntries,
+	}
+}
+
+// Get looks up key. The entry field of the cacheItem behind elem is mutated by Set, so every read
+// of it (including via MoveToBack's Element.Value) must happen under mu alongside the list update.
+func (c *memoryCache) Get(key string) (*CacheEntry, bool) {
+	v, ok := c.entries.Load(key)
+	if !ok {
+		return nil, false
+	}
+	elem := v.(*list.Element)
+
+	c.mu.Lock()
+	entry := elem.Value.(*cacheItem).entry
+	expired := c.ttl > 0 && time.Since(entry.StoredAt) > c.ttl
+	if !expired {
+		c.order.MoveToBack(elem)
+	}
+	c.mu.Unlock()
+
+	if expired {
+		c.remove(key, elem)
+		return nil, false
+	}
+
+	return entry, true
+}
+
+func (c *memoryCache) Set(key string, entry *CacheEntry) {
+	entry.StoredAt = time.Now()
+
+	c.mu.Lock()
+	if v, ok := c.entries.Load(key); ok {
+		elem := v.(*list.Element)
+		elem.Value.(*cacheItem).entry = entry
+		c.order.MoveToBack(elem)
+		c.mu.Unlock()
+		return
+	}
+
+	elem := c.order.PushBack(&cacheItem{key: key, entry: entry})
+	c.entries.Store(key, elem)
+	c.mu.Unlock()
+
+	c.evict()
+}
+
+func (c *memoryCache) evict() {
+	if c.maxEntries <= 0 {
+		return
+	}
+
+	for {
+		c.mu.Lock()
+		oldest := c.order.Front()
+		if c.order.Len() <= c.maxEntries || oldest == nil {
+			c.mu.Unlock()
+			return
+		}
+		c.mu.Unlock()
+
+		c.remove(oldest.Value.(*cacheItem).key, oldest)
+	}
+}
+
+func (c *memoryCache) remove(key string, elem *list.Element) {
+	c.entries.Delete(key)
+	c.mu.Lock()
+	c.order.Remove(elem)
+	c.mu.Unlock()
+}
+
+func (c *memoryCache) Invalidate(prefix string) {
+	var stale []string
+	c.entries.Range(func(k, _ interface{}) bool {
+		key := k.(string)
+		if strings.HasPrefix(key, prefix) {
+			stale = append(stale, key)
+		}
+		return true
+	})
+
+	for _, key := range stale {
+		if v, ok := c.entries.Load(key); ok {
+			c.remove(key, v.(*list.Element))
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package backstage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDo_CacheRevalidation tests that a cached GET is revalidated with If-None-Match and that a 304
+// response is served from the cache instead of the (empty) response body.
+func TestDo_CacheRevalidation(t *testing.T) {
+	const path = "/foo/bar"
+
+	var requests int
+	var ifNoneMatch string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		ifNoneMatch = r.Header.Get("If-None-Match")
+		if ifNoneMatch == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"foo":"bar"}`))
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	c, _ := NewClient(server.URL, "", nil, nil)
+	c.BaseURL = u
+
+	data1 := new(interface{})
+	req1, _ := c.newRequest(context.Background(), http.MethodGet, path, nil)
+	_, err1 := c.do(context.Background(), req1, data1)
+
+	data2 := new(interface{})
+	req2, _ := c.newRequest(context.Background(), http.MethodGet, path, nil)
+	resp2, err2 := c.do(context.Background(), req2, data2)
+
+	assert.NoError(t, err1, "First request should not return an error")
+	assert.NoError(t, err2, "Revalidated request should not return an error")
+	assert.Equal(t, `"v1"`, ifNoneMatch, "Second request should send If-None-Match with the cached ETag")
+	assert.Equal(t, http.StatusNotModified, resp2.StatusCode, "Server should have returned 304 Not Modified")
+	assert.Equal(t, data1, data2, "304 response should be decoded from the cached body")
+	assert.Equal(t, 2, requests, "Both requests should have reached the server")
+}
+
+// TestDo_CacheBypassedByNoCache tests that WithNoCache skips both cache lookup and storage.
+func TestDo_CacheBypassedByNoCache(t *testing.T) {
+	const path = "/foo/bar"
+
+	var ifNoneMatchSeen bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			ifNoneMatchSeen = true
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"foo":"bar"}`))
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	c, _ := NewClient(server.URL, "", nil, nil)
+	c.BaseURL = u
+
+	req1, _ := c.newRequest(context.Background(), http.MethodGet, path, nil, WithNoCache())
+	_, err1 := c.do(context.Background(), req1, new(interface{}))
+
+	req2, _ := c.newRequest(context.Background(), http.MethodGet, path, nil, WithNoCache())
+	_, err2 := c.do(context.Background(), req2, new(interface{}))
+
+	assert.NoError(t, err1, "First request should not return an error")
+	assert.NoError(t, err2, "Second request should not return an error")
+	assert.False(t, ifNoneMatchSeen, "Requests made with WithNoCache should never send a conditional header")
+
+	_, stored := c.cache.Get(u.String() + path)
+	assert.False(t, stored, "Responses to requests made with WithNoCache should not be stored in the cache")
+}
+
+// TestInvalidateCache tests that InvalidateCache forces a full GET instead of a revalidation.
+func TestInvalidateCache(t *testing.T) {
+	const path = "/foo/bar"
+
+	var ifNoneMatch string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch = r.Header.Get("If-None-Match")
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"foo":"bar"}`))
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	c, _ := NewClient(server.URL, "", nil, nil)
+	c.BaseURL = u
+
+	req1, _ := c.newRequest(context.Background(), http.MethodGet, path, nil)
+	_, _ = c.do(context.Background(), req1, new(interface{}))
+
+	c.InvalidateCache(u.String())
+
+	req2, _ := c.newRequest(context.Background(), http.MethodGet, path, nil)
+	_, _ = c.do(context.Background(), req2, new(interface{}))
+
+	assert.Empty(t, ifNoneMatch, "Request after InvalidateCache should not send a conditional header")
+}
+
+// TestDo_304WithoutCachedEntryReturnsError tests that a 304 response is not silently treated as
+// success when the cache has no entry to revalidate against, e.g. because it was evicted between
+// the conditional request being built and the response arriving.
+func TestDo_304WithoutCachedEntryReturnsError(t *testing.T) {
+	const path = "/foo/bar"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	c, _ := NewClient(server.URL, "", nil, nil)
+	c.BaseURL = u
+
+	req, _ := c.newRequest(context.Background(), http.MethodGet, path, nil)
+	data := new(interface{})
+	resp, err := c.do(context.Background(), req, data)
+
+	assert.Error(t, err, "do should return an error when a 304 arrives with no cached entry to serve")
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode, "do should still return the underlying response")
+	assert.Nil(t, *data, "v should be left untouched when do returns an error")
+}
+
+// TestMemoryCache_ConcurrentGetSet tests that concurrent Get/Set calls against the same key are
+// race-free. Run with -race to verify.
+func TestMemoryCache_ConcurrentGetSet(t *testing.T) {
+	cache := newMemoryCache(time.Minute, 0)
+
+	const key = "http://localhost/catalog/entities"
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			cache.Set(key, &CacheEntry{ETag: `"v"`, Body: []byte("body")})
+		}(i)
+		go func() {
+			defer wg.Done()
+			cache.Get(key)
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,227 @@
+package backstage
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// defaultIteratorPageSize is the number of entities requested per page when none is specified in
+// ListEntitiesOptions.
+const defaultIteratorPageSize = 500
+
+// catalogService handles communication with the Backstage Catalog API.
+type catalogService service
+
+func newCatalogService(c *Client) *catalogService {
+	return &catalogService{client: c, apiPath: "/catalog"}
+}
+
+// Entity is a Backstage catalog entity, e.g. a Component, API, or System.
+type Entity struct {
+	APIVersion string                 `json:"apiVersion"`
+	Kind       string                 `json:"kind"`
+	Metadata   EntityMetadata         `json:"metadata"`
+	Spec       map[string]interface{} `json:"spec,omitempty"`
+}
+
+// EntityMetadata holds the name, namespace, and descriptive fields common to every Entity.
+type EntityMetadata struct {
+	UID         string            `json:"uid,omitempty"`
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace,omitempty"`
+	Title       string            `json:"title,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+}
+
+// ListEntitiesOptions configures calls to Entities, EntitiesIterator, and EntitiesAll.
+type ListEntitiesOptions struct {
+	// Limit is the page size requested from Backstage. Defaults to 500.
+	Limit int
+
+	// Offset is the number of entities to skip, used to resume a query at a specific page.
+	Offset int
+
+	// Filter is a raw Backstage entity filter expression, e.g. "kind=component,metadata.namespace=default".
+	Filter string
+
+	// MaxResults caps the number of entities EntitiesAll will accumulate. Zero means unlimited.
+	MaxResults int
+}
+
+func (o ListEntitiesOptions) url(apiPath string) string {
+	limit := o.Limit
+	if limit <= 0 {
+		limit = defaultIteratorPageSize
+	}
+
+	q := url.Values{}
+	q.Set("limit", strconv.Itoa(limit))
+	if o.Offset > 0 {
+		q.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.Filter != "" {
+		q.Set("filter", o.Filter)
+	}
+
+	return apiPath + "/entities?" + q.Encode()
+}
+
+// Entities fetches a single page of catalog entities matching opts.
+func (s *catalogService) Entities(ctx context.Context, opts ListEntitiesOptions) ([]Entity, *http.Response, error) {
+	req, err := s.client.newRequest(ctx, http.MethodGet, opts.url(s.apiPath), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entities []Entity
+	resp, err := s.client.do(ctx, req, &entities)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return entities, resp, nil
+}
+
+// EntityIterator pages through the results of a catalog entity query, advancing via the Link
+// response header Backstage emits and falling back to offset bumping when it's absent.
+type EntityIterator struct {
+	svc     *catalogService
+	opts    ListEntitiesOptions
+	page    int
+	nextURL string
+
+	entities []Entity
+	idx      int
+
+	started   bool
+	exhausted bool
+	err       error
+}
+
+// EntitiesIterator returns an iterator over catalog entities matching opts, fetching pages of
+// opts.Limit (default 500) entities at a time.
+func (s *catalogService) EntitiesIterator(ctx context.Context, opts ListEntitiesOptions) *EntityIterator {
+	return &EntityIterator{svc: s, opts: opts}
+}
+
+// Next advances the iterator, fetching the next page from Backstage if the current one is
+// exhausted. It returns false once there are no more entities or an error occurs; check Err to
+// distinguish the two.
+func (it *EntityIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.idx++
+	if it.idx < len(it.entities) {
+		return true
+	}
+
+	if it.started && it.exhausted {
+		return false
+	}
+
+	if err := it.fetchPage(ctx); err != nil {
+		it.err = err
+		return false
+	}
+
+	it.idx = 0
+	return len(it.entities) > 0
+}
+
+func (it *EntityIterator) fetchPage(ctx context.Context) error {
+	urlStr := it.nextURL
+	if urlStr == "" {
+		urlStr = it.opts.url(it.svc.apiPath)
+	}
+
+	req, err := it.svc.client.newRequest(ctx, http.MethodGet, urlStr, nil)
+	if err != nil {
+		return err
+	}
+
+	var entities []Entity
+	resp, err := it.svc.client.do(ctx, req, &entities)
+	if err != nil {
+		return err
+	}
+
+	it.started = true
+	it.page++
+	it.entities = entities
+	it.nextURL = parseNextLink(resp.Header.Get("Link"))
+
+	limit := it.opts.Limit
+	if limit <= 0 {
+		limit = defaultIteratorPageSize
+	}
+	if it.nextURL == "" {
+		if len(entities) < limit {
+			it.exhausted = true
+		} else {
+			it.opts.Offset += len(entities)
+		}
+	}
+
+	return nil
+}
+
+// Entity returns the entity at the iterator's current position. Call it only after Next returns true.
+func (it *EntityIterator) Entity() Entity {
+	if it.idx < 0 || it.idx >= len(it.entities) {
+		return Entity{}
+	}
+	return it.entities[it.idx]
+}
+
+// Err returns the error, if any, that stopped the iterator.
+func (it *EntityIterator) Err() error {
+	return it.err
+}
+
+// Page returns the number of pages fetched from Backstage so far.
+func (it *EntityIterator) Page() int {
+	return it.page
+}
+
+// EntitiesAll drains EntitiesIterator into a slice, stopping early once opts.MaxResults entities
+// have been collected (if set) to avoid unbounded memory use against very large catalogs.
+func (s *catalogService) EntitiesAll(ctx context.Context, opts ListEntitiesOptions) ([]Entity, error) {
+	it := s.EntitiesIterator(ctx, opts)
+
+	var all []Entity
+	for it.Next(ctx) {
+		all = append(all, it.Entity())
+		if opts.MaxResults > 0 && len(all) >= opts.MaxResults {
+			break
+		}
+	}
+
+	return all, it.Err()
+}
+
+// parseNextLink extracts the "next" URL from an RFC 5988 Link header, e.g.
+// `<https://host/catalog/entities?offset=500>; rel="next"`.
+func parseNextLink(header string) string {
+	for _, part := range strings.Split(header, ",") {
+		sections := strings.Split(part, ";")
+		if len(sections) < 2 {
+			continue
+		}
+
+		for _, rel := range sections[1:] {
+			if strings.TrimSpace(rel) == `rel="next"` {
+				return strings.Trim(strings.TrimSpace(sections[0]), "<>")
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,96 @@
+package backstage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCatalogClient(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	u, _ := url.Parse(server.URL)
+	c := &Client{BaseURL: u, client: &http.Client{}, MaxRetries: 0}
+	c.Catalog = newCatalogService(c)
+
+	return c, server
+}
+
+// TestEntitiesIterator_OffsetFallback tests that the iterator pages using offset bumping when the
+// server does not emit a Link header.
+func TestEntitiesIterator_OffsetFallback(t *testing.T) {
+	pages := [][]Entity{
+		{{Kind: "Component", Metadata: EntityMetadata{Name: "a"}}, {Kind: "Component", Metadata: EntityMetadata{Name: "b"}}},
+		{{Kind: "Component", Metadata: EntityMetadata{Name: "c"}}},
+	}
+
+	var requests int
+	c, server := newTestCatalogClient(t, func(w http.ResponseWriter, r *http.Request) {
+		defer func() { requests++ }()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(pages[requests])
+	})
+	defer server.Close()
+
+	it := c.Catalog.EntitiesIterator(context.Background(), ListEntitiesOptions{Limit: 2})
+
+	var names []string
+	for it.Next(context.Background()) {
+		names = append(names, it.Entity().Metadata.Name)
+	}
+
+	assert.NoError(t, it.Err(), "Iterator should not end in an error")
+	assert.Equal(t, []string{"a", "b", "c"}, names, "Iterator should yield entities from every page in order")
+	assert.Equal(t, 2, it.Page(), "Iterator should have fetched 2 pages of entities")
+}
+
+// TestEntitiesIterator_LinkHeader tests that the iterator follows the Link: rel="next" header when present.
+func TestEntitiesIterator_LinkHeader(t *testing.T) {
+	var nextURL string
+	c, server := newTestCatalogClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "" {
+			w.Header().Set("Link", fmt.Sprintf(`<%s/catalog/entities?offset=1>; rel="next"`, nextURL))
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode([]Entity{{Kind: "Component", Metadata: EntityMetadata{Name: "a"}}})
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]Entity{})
+	})
+	defer server.Close()
+	nextURL = server.URL
+
+	it := c.Catalog.EntitiesIterator(context.Background(), ListEntitiesOptions{Limit: 1})
+
+	var names []string
+	for it.Next(context.Background()) {
+		names = append(names, it.Entity().Metadata.Name)
+	}
+
+	assert.NoError(t, it.Err(), "Iterator should not end in an error")
+	assert.Equal(t, []string{"a"}, names, "Iterator should yield the entity from the first page")
+}
+
+// TestEntitiesAll_MaxResults tests that EntitiesAll stops once MaxResults have been collected.
+func TestEntitiesAll_MaxResults(t *testing.T) {
+	c, server := newTestCatalogClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]Entity{
+			{Kind: "Component", Metadata: EntityMetadata{Name: "a"}},
+			{Kind: "Component", Metadata: EntityMetadata{Name: "b"}},
+		})
+	})
+	defer server.Close()
+
+	entities, err := c.Catalog.EntitiesAll(context.Background(), ListEntitiesOptions{Limit: 2, MaxResults: 1})
+
+	assert.NoError(t, err, "EntitiesAll should not return an error")
+	assert.Len(t, entities, 1, "EntitiesAll should stop once MaxResults entities have been collected")
+}
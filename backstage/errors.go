@@ -0,0 +1,76 @@
+package backstage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrorResponse reports the error caused by an API request. Backstage wraps
+// errors in a standard envelope of the form:
+//
+//	{"error":{"name":"...","message":"...","statusCode":...},"request":{...},"response":{...}}
+type ErrorResponse struct {
+	// Response is the HTTP response that produced this error.
+	Response *http.Response `json:"-"`
+
+	Error_ struct {
+		Name       string `json:"name"`
+		Message    string `json:"message"`
+		StatusCode int    `json:"statusCode"`
+	} `json:"error"`
+
+	Request struct {
+		Method string `json:"method"`
+		URL    string `json:"url"`
+	} `json:"request"`
+
+	RawResponse struct {
+		StatusCode int `json:"statusCode"`
+	} `json:"response"`
+}
+
+func (e *ErrorResponse) Error() string {
+	method, url := e.Request.Method, e.Request.URL
+	if e.Response != nil && e.Response.Request != nil {
+		method, url = e.Response.Request.Method, e.Response.Request.URL.String()
+	}
+
+	return fmt.Sprintf("%s %s: %d %s: %s", method, url, e.Error_.StatusCode, e.Error_.Name, e.Error_.Message)
+}
+
+// checkResponse returns an error if the response's status code is >= 400. It
+// attempts to unmarshal Backstage's standard error envelope into an
+// ErrorResponse, falling back to a generic error containing the raw body if
+// that fails.
+func checkResponse(resp *http.Response, body []byte) error {
+	if resp.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+
+	errResp := &ErrorResponse{Response: resp}
+	if jsonErr := json.Unmarshal(body, errResp); jsonErr != nil || errResp.Error_.Message == "" {
+		return fmt.Errorf("%s %s: %d: %s", resp.Request.Method, resp.Request.URL, resp.StatusCode, string(body))
+	}
+
+	return errResp
+}
+
+// IsNotFound reports whether err is an ErrorResponse for a 404 Not Found response.
+func IsNotFound(err error) bool {
+	return errorStatusCode(err) == http.StatusNotFound
+}
+
+// IsUnauthorized reports whether err is an ErrorResponse for a 401 Unauthorized response.
+func IsUnauthorized(err error) bool {
+	return errorStatusCode(err) == http.StatusUnauthorized
+}
+
+func errorStatusCode(err error) int {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return 0
+	}
+	return errResp.Response.StatusCode
+}
@@ -0,0 +1,78 @@
+package backstage
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// RequestHook is called with the outgoing request immediately before it is sent. It is invoked once
+// per attempt, including retries.
+type RequestHook func(req *http.Request)
+
+// ResponseHook is called with the response body and the time taken once a response is received. The
+// body is buffered ahead of time so it can be inspected here without disturbing JSON decoding.
+type ResponseHook func(resp *http.Response, body []byte, duration time.Duration)
+
+// OnRequest registers a hook that is called with every outgoing request.
+func (c *Client) OnRequest(hook RequestHook) {
+	c.requestHooks = append(c.requestHooks, hook)
+}
+
+// OnResponse registers a hook that is called with every response received.
+func (c *Client) OnResponse(hook ResponseHook) {
+	c.responseHooks = append(c.responseHooks, hook)
+}
+
+// SetDebug is a shortcut for registering a DebugLogger that writes to os.Stderr.
+func (c *Client) SetDebug(enabled bool) {
+	if !enabled {
+		return
+	}
+
+	logger := NewDebugLogger(os.Stderr)
+	c.OnRequest(logger.LogRequest)
+	c.OnResponse(logger.LogResponse)
+}
+
+// DebugLogger logs requests and responses for troubleshooting, redacting the Authorization header
+// and pretty-printing JSON bodies.
+type DebugLogger struct {
+	w io.Writer
+}
+
+// NewDebugLogger returns a DebugLogger that writes to w.
+func NewDebugLogger(w io.Writer) *DebugLogger {
+	return &DebugLogger{w: w}
+}
+
+// LogRequest writes the method, URL, and headers of req, suitable for use as a RequestHook.
+func (d *DebugLogger) LogRequest(req *http.Request) {
+	fmt.Fprintf(d.w, "--> %s %s\n", req.Method, req.URL)
+	for name, values := range req.Header {
+		if strings.EqualFold(name, "Authorization") {
+			fmt.Fprintf(d.w, "%s: REDACTED\n", name)
+			continue
+		}
+		fmt.Fprintf(d.w, "%s: %s\n", name, strings.Join(values, ","))
+	}
+}
+
+// LogResponse writes the status, duration, and pretty-printed JSON body of resp, suitable for use
+// as a ResponseHook.
+func (d *DebugLogger) LogResponse(resp *http.Response, body []byte, duration time.Duration) {
+	fmt.Fprintf(d.w, "<-- %d %s (%s)\n", resp.StatusCode, resp.Request.URL, duration)
+
+	var pretty bytes.Buffer
+	if json.Indent(&pretty, body, "", "  ") == nil {
+		_, _ = d.w.Write(pretty.Bytes())
+	} else {
+		_, _ = d.w.Write(body)
+	}
+	fmt.Fprintln(d.w)
+}
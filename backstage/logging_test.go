@@ -0,0 +1,37 @@
+package backstage
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDebugLogger_LogRequest_RedactsAuthorization tests that the Authorization header is never written as-is.
+func TestDebugLogger_LogRequest_RedactsAuthorization(t *testing.T) {
+	var buf strings.Builder
+	logger := NewDebugLogger(&buf)
+
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/foo", nil)
+	req.Header.Set("Authorization", "Bearer super-secret")
+
+	logger.LogRequest(req)
+
+	assert.NotContains(t, buf.String(), "super-secret", "LogRequest should not leak the Authorization header value")
+	assert.Contains(t, buf.String(), "REDACTED", "LogRequest should redact the Authorization header")
+}
+
+// TestDebugLogger_LogResponse_PrettyPrintsJSON tests that a JSON body is indented in the log output.
+func TestDebugLogger_LogResponse_PrettyPrintsJSON(t *testing.T) {
+	var buf strings.Builder
+	logger := NewDebugLogger(&buf)
+
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/foo", nil)
+	resp := &http.Response{StatusCode: http.StatusOK, Request: req}
+
+	logger.LogResponse(resp, []byte(`{"foo":"bar"}`), 10*time.Millisecond)
+
+	assert.Contains(t, buf.String(), "\"foo\": \"bar\"", "LogResponse should pretty-print the JSON body")
+}
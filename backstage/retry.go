@@ -0,0 +1,91 @@
+package backstage
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMaxRetries = 3
+	retryBaseDelay    = 500 * time.Millisecond
+	retryMaxDelay     = 30 * time.Second
+)
+
+// RetryConditional decides whether a request should be retried given the
+// response it produced (which may be nil) and/or the error returned by the
+// underlying http.Client.
+type RetryConditional func(resp *http.Response, err error) bool
+
+// AddRetryCondition registers an additional condition under which a request
+// will be retried. Conditions are evaluated in the order they were added; a
+// request is retried as soon as any condition returns true.
+func (c *Client) AddRetryCondition(condition RetryConditional) {
+	c.retryConditions = append(c.retryConditions, condition)
+}
+
+func (c *Client) shouldRetry(resp *http.Response, err error) bool {
+	for _, cond := range c.retryConditions {
+		if cond(resp, err) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryDelay returns how long to wait before the next attempt, honoring a
+// Retry-After header on 429 responses and otherwise backing off with full
+// jitter: rand(min(cap, base*2^attempt)).
+func retryDelay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		if d, ok := retryAfterDelay(resp.Header.Get("Retry-After")); ok {
+			return d
+		}
+	}
+
+	delayCap := retryBaseDelay * (1 << uint(attempt))
+	if delayCap > retryMaxDelay || delayCap <= 0 {
+		delayCap = retryMaxDelay
+	}
+
+	return time.Duration(rand.Int63n(int64(delayCap)))
+}
+
+func retryAfterDelay(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(header); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+	if when, err := http.ParseTime(header); err == nil {
+		return time.Until(when), true
+	}
+	return 0, false
+}
+
+// retryOnTooManyRequests retries 429 Too Many Requests responses.
+func retryOnTooManyRequests(resp *http.Response, _ error) bool {
+	return resp != nil && resp.StatusCode == http.StatusTooManyRequests
+}
+
+// retryOnServerUnavailable retries 502/503/504 responses, which are commonly
+// emitted by gateways fronting Backstage during deploys.
+func retryOnServerUnavailable(resp *http.Response, _ error) bool {
+	if resp == nil {
+		return false
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryOnConnectionReset retries requests that failed because the connection was reset by the peer.
+func retryOnConnectionReset(_ *http.Response, err error) bool {
+	return err != nil && strings.Contains(err.Error(), "connection reset by peer")
+}